@@ -0,0 +1,54 @@
+package automation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+)
+
+func TestAccDataSourceAzureRMAutomationAccount_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_automation_account", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { acceptance.PreCheck(t) },
+		Providers: acceptance.SupportedProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMAutomationAccount_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(data.ResourceName, "sku_name"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "dsc_server_endpoint"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "dsc_primary_access_key"),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "dsc_secondary_access_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMAutomationAccount_basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-automation-%d"
+  location = "%s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctest-automation-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "Basic"
+}
+
+data "azurerm_automation_account" "test" {
+  name                = azurerm_automation_account.test.name
+  resource_group_name = azurerm_automation_account.test.resource_group_name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -5,7 +5,10 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/automation/mgmt/2015-10-31/automation"
+	// NOTE: Identity/Encryption/PublicNetworkAccess/PrivateEndpointConnections require the
+	// 2021-06-22 (or later) package - `go mod vendor` must be re-run against a go.mod pinned
+	// to that version before this builds; it is not yet reflected in vendor/go.sum.
+	"github.com/Azure/azure-sdk-for-go/services/automation/mgmt/2021-06-22/automation"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
@@ -13,6 +16,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/automation/parse"
+	keyVaultParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
@@ -59,6 +64,91 @@ func resourceArmAutomationAccount() *schema.Resource {
 				}, false),
 			},
 
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(automation.ResourceIdentityTypeSystemAssigned),
+								string(automation.ResourceIdentityTypeUserAssigned),
+								string(automation.ResourceIdentityTypeSystemAssignedUserAssigned),
+							}, false),
+						},
+
+						"identity_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: azure.ValidateResourceID,
+							},
+						},
+
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"encryption": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_vault_key_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+						},
+
+						"user_assigned_identity_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+					},
+				},
+			},
+
+			"public_network_access_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"private_endpoint_connection": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"tags": tags.Schema(),
 
 			"dsc_server_endpoint": {
@@ -107,10 +197,28 @@ func resourceArmAutomationAccountCreateUpdate(d *schema.ResourceData, meta inter
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
 
+	identity, err := expandAutomationAccountIdentity(d.Get("identity").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	encryption, err := expandAutomationAccountEncryption(d.Get("encryption").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("parsing `encryption`: %+v", err)
+	}
+
+	publicNetworkAccess := automation.PublicNetworkAccessTypeEnabled
+	if !d.Get("public_network_access_enabled").(bool) {
+		publicNetworkAccess = automation.PublicNetworkAccessTypeDisabled
+	}
+
 	parameters := automation.AccountCreateOrUpdateParameters{
 		AccountCreateOrUpdateProperties: &automation.AccountCreateOrUpdateProperties{
-			Sku: &sku,
+			Sku:                 &sku,
+			Encryption:          encryption,
+			PublicNetworkAccess: publicNetworkAccess,
 		},
+		Identity: identity,
 		Location: utils.String(location),
 		Tags:     tags.Expand(t),
 	}
@@ -180,6 +288,24 @@ func resourceArmAutomationAccountRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error making Read request on Automation Account %q (Resource Group %q): Unable to retrieve 'sku' value", id.Name, id.ResourceGroup)
 	}
 
+	if err := d.Set("identity", flattenAutomationAccountIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting 'identity': %+v", err)
+	}
+
+	encryption, err := flattenAutomationAccountEncryption(resp.Encryption)
+	if err != nil {
+		return fmt.Errorf("flattening `encryption`: %+v", err)
+	}
+	if err := d.Set("encryption", encryption); err != nil {
+		return fmt.Errorf("Error setting 'encryption': %+v", err)
+	}
+
+	d.Set("public_network_access_enabled", resp.PublicNetworkAccess != automation.PublicNetworkAccessTypeDisabled)
+
+	if err := d.Set("private_endpoint_connection", flattenAutomationAccountPrivateEndpointConnections(resp.PrivateEndpointConnections)); err != nil {
+		return fmt.Errorf("Error setting 'private_endpoint_connection': %+v", err)
+	}
+
 	d.Set("dsc_server_endpoint", keysResp.Endpoint)
 	if keys := keysResp.Keys; keys != nil {
 		d.Set("dsc_primary_access_key", keys.Primary)
@@ -215,3 +341,157 @@ func resourceArmAutomationAccountDelete(d *schema.ResourceData, meta interface{}
 
 	return nil
 }
+
+func expandAutomationAccountIdentity(input []interface{}) (*automation.Identity, error) {
+	if len(input) == 0 || input[0] == nil {
+		return &automation.Identity{
+			Type: automation.ResourceIdentityTypeNone,
+		}, nil
+	}
+
+	v := input[0].(map[string]interface{})
+	identityType := automation.ResourceIdentityType(v["type"].(string))
+
+	identityIds := make(map[string]*automation.AccountIdentityUserAssignedIdentitiesValue)
+	for _, id := range v["identity_ids"].(*schema.Set).List() {
+		identityIds[id.(string)] = &automation.AccountIdentityUserAssignedIdentitiesValue{}
+	}
+
+	if len(identityIds) > 0 && identityType != automation.ResourceIdentityTypeUserAssigned && identityType != automation.ResourceIdentityTypeSystemAssignedUserAssigned {
+		return nil, fmt.Errorf("`identity_ids` can only be specified when `type` is `UserAssigned` or `SystemAssigned, UserAssigned`")
+	}
+
+	identity := automation.Identity{
+		Type: identityType,
+	}
+
+	if len(identityIds) > 0 {
+		identity.UserAssignedIdentities = identityIds
+	}
+
+	return &identity, nil
+}
+
+func flattenAutomationAccountPrivateEndpointConnections(input *[]automation.PrivateEndpointConnection) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	results := make([]interface{}, 0)
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		id := ""
+		if item.ID != nil {
+			id = *item.ID
+		}
+
+		results = append(results, map[string]interface{}{
+			"name": name,
+			"id":   id,
+		})
+	}
+
+	return results
+}
+
+func expandAutomationAccountEncryption(input []interface{}) (*automation.EncryptionProperties, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	keyVaultKeyID, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(v["key_vault_key_id"].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	encryption := automation.EncryptionProperties{
+		KeySource: automation.MicrosoftKeyvault,
+		KeyVaultProperties: &automation.KeyVaultProperties{
+			KeyvaultURI: utils.String(keyVaultKeyID.KeyVaultBaseUrl),
+			KeyName:     utils.String(keyVaultKeyID.Name),
+			KeyVersion:  utils.String(keyVaultKeyID.Version),
+		},
+	}
+
+	if userAssignedIdentityID := v["user_assigned_identity_id"].(string); userAssignedIdentityID != "" {
+		encryption.Identity = &automation.EncryptionPropertiesIdentity{
+			UserAssignedIdentity: utils.String(userAssignedIdentityID),
+		}
+	}
+
+	return &encryption, nil
+}
+
+func flattenAutomationAccountEncryption(input *automation.EncryptionProperties) ([]interface{}, error) {
+	if input == nil || input.KeyVaultProperties == nil {
+		return []interface{}{}, nil
+	}
+
+	keyVaultURI := ""
+	if input.KeyVaultProperties.KeyvaultURI != nil {
+		keyVaultURI = *input.KeyVaultProperties.KeyvaultURI
+	}
+
+	keyName := ""
+	if input.KeyVaultProperties.KeyName != nil {
+		keyName = *input.KeyVaultProperties.KeyName
+	}
+
+	keyVersion := ""
+	if input.KeyVaultProperties.KeyVersion != nil {
+		keyVersion = *input.KeyVaultProperties.KeyVersion
+	}
+
+	keyVaultKeyID, err := keyVaultParse.NewNestedItemID(keyVaultURI, keyVaultParse.NestedItemTypeKey, keyName, keyVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	userAssignedIdentityID := ""
+	if input.Identity != nil && input.Identity.UserAssignedIdentity != nil {
+		userAssignedIdentityID = *input.Identity.UserAssignedIdentity
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"key_vault_key_id":          keyVaultKeyID.ID(),
+			"user_assigned_identity_id": userAssignedIdentityID,
+		},
+	}, nil
+}
+
+func flattenAutomationAccountIdentity(input *automation.Identity) []interface{} {
+	if input == nil || input.Type == automation.ResourceIdentityTypeNone {
+		return []interface{}{}
+	}
+
+	identityIds := make([]interface{}, 0)
+	for id := range input.UserAssignedIdentities {
+		identityIds = append(identityIds, id)
+	}
+
+	principalID := ""
+	if input.PrincipalID != nil {
+		principalID = *input.PrincipalID
+	}
+
+	tenantID := ""
+	if input.TenantID != nil {
+		tenantID = *input.TenantID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         string(input.Type),
+			"identity_ids": schema.NewSet(schema.HashString, identityIds),
+			"principal_id": principalID,
+			"tenant_id":    tenantID,
+		},
+	}
+}